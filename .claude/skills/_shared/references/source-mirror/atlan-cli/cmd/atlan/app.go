@@ -0,0 +1,90 @@
+package cmd
+
+import (
+	"os"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/atlanhq/atlan-cli/pkg/atlan"
+	"github.com/spf13/cobra"
+)
+
+var (
+	AppCommandShort = "Manage Atlan applications"
+	AppCommandLong  = heredoc.Doc(`
+		The app command helps you manage Atlan applications, including initialization,
+		setup, release, and lifecycle management.
+
+		Use --allow-commands to restrict which subcommands are usable, e.g. to ship
+		a locked-down binary to CI runners or contractors. It accepts a
+		comma-separated list of subcommand names (init, run, test, release, ...) or
+		the keyword "all". The ATLAN_ALLOW_COMMANDS environment variable sets an
+		org-wide default when the flag isn't passed.
+	`)
+
+	AppCommandAllowCommandsFlagDesc = `Comma-separated list of allowed subcommands (e.g. "init,run,test"), or "all"`
+)
+
+const (
+	// AllowCommandsEnvVar lets an enterprise config set an org-wide
+	// default for --allow-commands without every invocation passing it.
+	AllowCommandsEnvVar = "ATLAN_ALLOW_COMMANDS"
+)
+
+func buildAppCommand(a *atlan.Atlan) *cobra.Command {
+	var allowCommands string
+
+	cmd := &cobra.Command{
+		Use:     AppCommand,
+		Short:   AppCommandShort,
+		Long:    AppCommandLong,
+		GroupID: CORE_GROUP,
+		Hidden:  true, // Hide from help output until ready for public release
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			value := allowCommands
+			if !cmd.Flags().Changed(AllowCommandsFlag) {
+				if envValue := os.Getenv(AllowCommandsEnvVar); envValue != "" {
+					value = envValue
+				}
+			}
+			a.AllowedCommands = atlan.ParseAllowCommands(value)
+
+			// Walk up to the subcommand directly under "app" (e.g. release
+			// for `app release package`) so the allow-list gates whole
+			// command trees, not just leaf commands. cmd may already be
+			// "app" itself (e.g. bare `atlan app`), in which case there's
+			// nothing to walk.
+			target := cmd
+			for target.Name() != AppCommand && target.Parent() != nil && target.Parent().Name() != AppCommand {
+				target = target.Parent()
+			}
+
+			if err := atlan.CheckCommandAllowed(a.AllowedCommands, target.Name()); err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}
+
+	cmd.AddGroup(&cobra.Group{
+		ID:    CORE_GROUP,
+		Title: CORE_GROUP_TITLE,
+	})
+
+	cmd.PersistentFlags().StringVar(
+		&allowCommands,
+		AllowCommandsFlag,
+		AllowCommandsDefault,
+		AppCommandAllowCommandsFlagDesc,
+	)
+
+	// Register subcommands
+	cmd.AddCommand(buildAppInitCommand(a))
+	cmd.AddCommand(buildAppTemplateCommand(a))
+	cmd.AddCommand(buildAppSampleCommand(a))
+	cmd.AddCommand(buildAppRunCommand(a))
+	cmd.AddCommand(buildAppTestCommand(a))
+	cmd.AddCommand(buildAppReleaseCommand(a))
+
+	return cmd
+}
@@ -1,15 +1,21 @@
 package cmd
 
 import (
+	"os"
 	"time"
 
 	"github.com/MakeNowJust/heredoc"
 	"github.com/atlanhq/atlan-cli/pkg/atlan"
+	"github.com/atlanhq/atlan-cli/pkg/atlan/credstore"
 	"github.com/atlanhq/atlan-cli/pkg/logger"
 	"github.com/atlanhq/atlan-cli/pkg/utils"
 	"github.com/spf13/cobra"
 )
 
+// CredentialStoreEnvVar lets an enterprise config set an org-wide default
+// for --credential-store without every invocation passing it.
+const CredentialStoreEnvVar = "ATLAN_CREDENTIAL_STORE"
+
 var (
 	AppReleaseCommandShort = "Package, stage, and validate a Docker image"
 	AppReleaseCommandLong  = heredoc.Doc(`
@@ -30,6 +36,22 @@ var (
 		Credentials can be provided via flags (-u/--password), loaded from saved
 		credentials, or prompted interactively. They are automatically saved for
 		future use.
+
+		Saved credentials are keyed by registry hostname and stored in the
+		backend selected by --credential-store (keychain, file, env; defaults
+		to keychain) so multiple Harbor/ECR/GCR targets can coexist. Manage
+		them explicitly with the release login/logout subcommands.
+
+		Use --sbom to generate a software bill of materials from the built
+		image, and --attest/--sign to sign the image and SBOM and record an
+		attestation reference that the validate phase checks for alongside
+		CVE results.
+
+		The validate phase scans with the backend selected by --scanner
+		(harbor, trivy, grype, snyk; defaults to harbor) and fails the
+		release once vulnerabilities reach the --fail-on threshold. Use
+		--report-out to write the merged scan report as JSON or SARIF for
+		CI consumption.
 	`)
 	AppReleaseCommandExample = heredoc.Doc(`
 		# Release with default settings (prompts for credentials if not saved)
@@ -44,9 +66,27 @@ var (
 		# Dry run: validate setup without building or pushing
 		$ atlan app release harbor.atlan.com/proj/app:v1 --dry-run
 
+		# Generate an SBOM and sign/attest the image and SBOM
+		$ atlan app release harbor.atlan.com/proj/app:v1 --sbom spdx-json --attest
+
+		# Scan with Trivy and fail only on fixable high-or-above CVEs
+		$ atlan app release harbor.atlan.com/proj/app:v1 --scanner trivy --fail-on high,fixable-only --report-out scan.sarif
+
+		# Save credentials for a registry once, reuse on every release after
+		$ atlan app release login harbor.atlan.com --credential-store file
+
 	`)
 
-	AppReleasePathFlagDesc = "Path to Dockerfile directory"
+	AppReleasePathFlagDesc      = "Path to Dockerfile directory"
+	AppReleaseSbomFlagDesc      = "Generate an SBOM from the built image (spdx-json, cyclonedx-json)"
+	AppReleaseAttestFlagDesc    = "Sign the image and SBOM and record an in-toto attestation reference"
+	AppReleaseSignFlagDesc      = "Sign the pushed image (implied by --attest)"
+	AppReleaseKeyFlagDesc       = "Local key file to sign with instead of keyless OIDC (Fulcio)"
+	AppReleaseScannerFlagDesc   = "Vulnerability scanner backend: harbor, trivy, grype, snyk"
+	AppReleaseFailOnFlagDesc    = "Fail the release once vulnerabilities reach this severity[,fixable-only] threshold"
+	AppReleaseReportOutFlagDesc = "Write the merged scan report to this path (.sarif for SARIF, otherwise JSON)"
+
+	AppReleaseCredentialStoreFlagDesc = "Credential store backend: keychain, file, env"
 )
 
 const (
@@ -69,6 +109,12 @@ func buildAppReleaseCommand(a *atlan.Atlan) *cobra.Command {
 			a.SegmentTrackEventInfo.SubModule = APP
 			opts.Image = args[0]
 
+			if !cmd.Flags().Changed(CredentialStoreFlag) {
+				if envValue := os.Getenv(CredentialStoreEnvVar); envValue != "" {
+					opts.CredentialStore = envValue
+				}
+			}
+
 			return nil
 		},
 		Run: func(cmd *cobra.Command, args []string) {
@@ -108,6 +154,38 @@ func buildAppReleaseCommand(a *atlan.Atlan) *cobra.Command {
 				flags = append(flags, DryRunFlag)
 			}
 
+			if opts.Sbom != "" {
+				flags = append(flags, SbomFlag)
+			}
+
+			if opts.Attest {
+				flags = append(flags, AttestFlag)
+			}
+
+			if opts.Sign {
+				flags = append(flags, SignFlag)
+			}
+
+			if opts.Key != "" {
+				flags = append(flags, KeyFlag)
+			}
+
+			if opts.Scanner != "" {
+				flags = append(flags, ScannerFlag)
+			}
+
+			if opts.FailOn != "" {
+				flags = append(flags, FailOnFlag)
+			}
+
+			if opts.ReportOut != "" {
+				flags = append(flags, ReportOutFlag)
+			}
+
+			if opts.CredentialStore != "" {
+				flags = append(flags, CredentialStoreFlag)
+			}
+
 			a.SetSegmentTrackProperties(
 				nil,
 				nil,
@@ -123,6 +201,8 @@ func buildAppReleaseCommand(a *atlan.Atlan) *cobra.Command {
 	cmd.AddCommand(buildAppReleasePackageCommand(a))
 	cmd.AddCommand(buildAppReleaseStageCommand(a))
 	cmd.AddCommand(buildAppReleaseValidateCommand(a))
+	cmd.AddCommand(buildAppReleaseLoginCommand(a))
+	cmd.AddCommand(buildAppReleaseLogoutCommand(a))
 
 	f := cmd.Flags()
 
@@ -171,5 +251,61 @@ func buildAppReleaseCommand(a *atlan.Atlan) *cobra.Command {
 		"Validate setup without building or pushing (fast validation)",
 	)
 
+	f.StringVar(
+		&opts.Sbom,
+		SbomFlag,
+		"",
+		AppReleaseSbomFlagDesc,
+	)
+
+	f.BoolVar(
+		&opts.Attest,
+		AttestFlag,
+		false,
+		AppReleaseAttestFlagDesc,
+	)
+
+	f.BoolVar(
+		&opts.Sign,
+		SignFlag,
+		false,
+		AppReleaseSignFlagDesc,
+	)
+
+	f.StringVar(
+		&opts.Key,
+		KeyFlag,
+		"",
+		AppReleaseKeyFlagDesc,
+	)
+
+	f.StringVar(
+		&opts.Scanner,
+		ScannerFlag,
+		atlan.DefaultScanner,
+		AppReleaseScannerFlagDesc,
+	)
+
+	f.StringVar(
+		&opts.FailOn,
+		FailOnFlag,
+		atlan.SeverityCritical,
+		AppReleaseFailOnFlagDesc,
+	)
+
+	f.StringVar(
+		&opts.ReportOut,
+		ReportOutFlag,
+		"",
+		AppReleaseReportOutFlagDesc,
+	)
+
+	f.StringVar(
+		&opts.CredentialStore,
+		CredentialStoreFlag,
+		credstore.DefaultBackend,
+		AppReleaseCredentialStoreFlagDesc,
+	)
+
 	return cmd
 }
@@ -0,0 +1,71 @@
+package cmd
+
+import (
+	"github.com/MakeNowJust/heredoc"
+	"github.com/atlanhq/atlan-cli/pkg/atlan"
+	"github.com/atlanhq/atlan-cli/pkg/atlan/credstore"
+	"github.com/atlanhq/atlan-cli/pkg/logger"
+	"github.com/spf13/cobra"
+)
+
+var (
+	AppReleaseLoginCommandShort = "Save credentials for a registry"
+	AppReleaseLoginCommandLong  = heredoc.Doc(`
+		The atlan app release login command saves a username/password for
+		<registry> in the configured --credential-store, so future
+		'atlan app release' invocations against that host don't need -u/--password.
+	`)
+)
+
+const (
+	AppReleaseLoginSubCommand = "login"
+)
+
+func buildAppReleaseLoginCommand(a *atlan.Atlan) *cobra.Command {
+	opts := atlan.AppReleaseLoginOptions{}
+
+	cmd := &cobra.Command{
+		Use:     AppReleaseLoginSubCommand + " <registry>",
+		Short:   AppReleaseLoginCommandShort,
+		Long:    AppReleaseLoginCommandLong,
+		Args:    cobra.ExactArgs(1),
+		GroupID: CORE_GROUP,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			defer logger.Log.Info("[PreCheck] finished command prechecks")
+
+			opts.Registry = args[0]
+			return nil
+		},
+		Run: func(cmd *cobra.Command, args []string) {
+			if atlanErr := a.AppReleaseLogin(opts); atlanErr != nil {
+				a.HandleCommandError(atlanErr)
+			}
+		},
+	}
+
+	f := cmd.Flags()
+
+	f.StringVarP(
+		&opts.Username,
+		UsernameFlag,
+		UsernameFlagShorthand,
+		"",
+		AppReleaseUsernameFlagDesc,
+	)
+
+	f.StringVar(
+		&opts.Password,
+		PasswordFlag,
+		"",
+		AppReleasePasswordFlagDesc,
+	)
+
+	f.StringVar(
+		&opts.CredentialStore,
+		CredentialStoreFlag,
+		credstore.DefaultBackend,
+		AppReleaseCredentialStoreFlagDesc,
+	)
+
+	return cmd
+}
@@ -0,0 +1,55 @@
+package cmd
+
+import (
+	"github.com/MakeNowJust/heredoc"
+	"github.com/atlanhq/atlan-cli/pkg/atlan"
+	"github.com/atlanhq/atlan-cli/pkg/atlan/credstore"
+	"github.com/atlanhq/atlan-cli/pkg/logger"
+	"github.com/spf13/cobra"
+)
+
+var (
+	AppReleaseLogoutCommandShort = "Remove saved credentials for a registry"
+	AppReleaseLogoutCommandLong  = heredoc.Doc(`
+		The atlan app release logout command removes the credentials saved for
+		<registry> from the configured --credential-store.
+	`)
+)
+
+const (
+	AppReleaseLogoutSubCommand = "logout"
+)
+
+func buildAppReleaseLogoutCommand(a *atlan.Atlan) *cobra.Command {
+	opts := atlan.AppReleaseLogoutOptions{}
+
+	cmd := &cobra.Command{
+		Use:     AppReleaseLogoutSubCommand + " <registry>",
+		Short:   AppReleaseLogoutCommandShort,
+		Long:    AppReleaseLogoutCommandLong,
+		Args:    cobra.ExactArgs(1),
+		GroupID: CORE_GROUP,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			defer logger.Log.Info("[PreCheck] finished command prechecks")
+
+			opts.Registry = args[0]
+			return nil
+		},
+		Run: func(cmd *cobra.Command, args []string) {
+			if atlanErr := a.AppReleaseLogout(opts); atlanErr != nil {
+				a.HandleCommandError(atlanErr)
+			}
+		},
+	}
+
+	f := cmd.Flags()
+
+	f.StringVar(
+		&opts.CredentialStore,
+		CredentialStoreFlag,
+		credstore.DefaultBackend,
+		AppReleaseCredentialStoreFlagDesc,
+	)
+
+	return cmd
+}
@@ -0,0 +1,87 @@
+package cmd
+
+import (
+	"github.com/MakeNowJust/heredoc"
+	"github.com/atlanhq/atlan-cli/pkg/atlan"
+	"github.com/atlanhq/atlan-cli/pkg/logger"
+	"github.com/spf13/cobra"
+)
+
+var (
+	AppReleaseValidateCommandShort = "Scan a pushed image and add the release label if it passes"
+	AppReleaseValidateCommandLong  = heredoc.Doc(`
+		The atlan app release validate command scans a previously pushed
+		image with the backend selected by --scanner (harbor, trivy, grype,
+		snyk; defaults to harbor), fails once vulnerabilities reach the
+		--fail-on threshold, and otherwise adds --label to the image. When
+		the image was signed with --attest/--sign, its attestation
+		reference is also checked.
+
+		Use --report-out to write the scan report as JSON or SARIF for CI
+		consumption.
+	`)
+)
+
+const (
+	AppReleaseValidateSubCommand = "validate"
+)
+
+func buildAppReleaseValidateCommand(a *atlan.Atlan) *cobra.Command {
+	opts := atlan.AppReleaseOptions{}
+
+	cmd := &cobra.Command{
+		Use:     AppReleaseValidateSubCommand + " <image>",
+		Short:   AppReleaseValidateCommandShort,
+		Long:    AppReleaseValidateCommandLong,
+		Args:    cobra.ExactArgs(1),
+		GroupID: CORE_GROUP,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			defer logger.Log.Info("[PreCheck] finished command prechecks")
+
+			a.SegmentTrackEventInfo.SubModule = APP
+			opts.Image = args[0]
+
+			return nil
+		},
+		Run: func(cmd *cobra.Command, args []string) {
+			atlanErr := a.AppReleaseValidate(opts)
+			if atlanErr != nil {
+				a.HandleCommandError(atlanErr)
+				return
+			}
+		},
+	}
+
+	f := cmd.Flags()
+
+	f.StringVarP(
+		&opts.Label,
+		LabelFlag,
+		LabelFlagShorthand,
+		atlan.ReplicateLabel,
+		AppReleaseValidateFlagDesc,
+	)
+
+	f.StringVar(
+		&opts.Scanner,
+		ScannerFlag,
+		atlan.DefaultScanner,
+		AppReleaseScannerFlagDesc,
+	)
+
+	f.StringVar(
+		&opts.FailOn,
+		FailOnFlag,
+		atlan.SeverityCritical,
+		AppReleaseFailOnFlagDesc,
+	)
+
+	f.StringVar(
+		&opts.ReportOut,
+		ReportOutFlag,
+		"",
+		AppReleaseReportOutFlagDesc,
+	)
+
+	return cmd
+}
@@ -20,15 +20,31 @@ var (
 
 		Port defaults can be overridden via environment variables.
 		See documentation for details.
+
+		Hot reload watches the --path root for changes, skipping anything
+		matched by a .atlanignore file (gitignore syntax) there so large
+		node_modules/.venv trees don't churn the watcher. Use
+		--watch-include/--watch-exclude to tune this further,
+		--watch-debounce to coalesce bursts of changes, --watch-signal to
+		choose how the child process is restarted, and --watch-poll on
+		filesystems (NFS, Docker Desktop bind mounts) where native file
+		events are unreliable.
 	`)
 	AppRunCommandExample = heredoc.Doc(`
 		$ atlan app run                          # Run app in current directory
 		$ atlan app run --no-watch               # Run without hot reload
 		$ atlan app run -p ./my-app              # Run app in specified directory
+		$ atlan app run --watch-exclude "*.log" --watch-debounce 500ms
+		$ atlan app run --watch-poll             # Poll instead of fsnotify (NFS, Docker Desktop)
 	`)
 
-	AppRunCommandPathFlag    = "Path to app directory"
-	AppRunCommandNoWatchFlag = "Disable hot reload (hot reload is enabled by default)"
+	AppRunCommandPathFlag          = "Path to app directory"
+	AppRunCommandNoWatchFlag       = "Disable hot reload (hot reload is enabled by default)"
+	AppRunCommandWatchIncludeFlag  = "Glob(s) of paths to watch, beyond the default (repeatable)"
+	AppRunCommandWatchExcludeFlag  = "Glob(s) of paths to ignore, in addition to .atlanignore (repeatable)"
+	AppRunCommandWatchDebounceFlag = "Coalesce bursts of file changes within this duration into one reload"
+	AppRunCommandWatchSignalFlag   = "How to restart the child process on reload: sigterm, sighup, restart"
+	AppRunCommandWatchPollFlag     = "Poll for file changes instead of using fsnotify"
 )
 
 const (
@@ -77,5 +93,40 @@ func buildAppRunCommand(a *atlan.Atlan) *cobra.Command {
 		AppRunCommandNoWatchFlag,
 	)
 
+	f.StringArrayVar(
+		&opts.WatchInclude,
+		WatchIncludeFlag,
+		nil,
+		AppRunCommandWatchIncludeFlag,
+	)
+
+	f.StringArrayVar(
+		&opts.WatchExclude,
+		WatchExcludeFlag,
+		nil,
+		AppRunCommandWatchExcludeFlag,
+	)
+
+	f.DurationVar(
+		&opts.WatchDebounce,
+		WatchDebounceFlag,
+		atlan.DefaultWatchDebounce,
+		AppRunCommandWatchDebounceFlag,
+	)
+
+	f.StringVar(
+		&opts.WatchSignal,
+		WatchSignalFlag,
+		atlan.WatchSignalSigterm,
+		AppRunCommandWatchSignalFlag,
+	)
+
+	f.BoolVar(
+		&opts.WatchPoll,
+		WatchPollFlag,
+		false,
+		AppRunCommandWatchPollFlag,
+	)
+
 	return cmd
 }
@@ -20,18 +20,28 @@ var (
 
 		E2E tests use the same ports as 'atlan app run'. Port defaults can be
 		overridden via environment variables. See documentation for details.
+
+		Use --report-format/--report-out to convert pytest's output into a
+		machine-readable file (junit, json, or tap). For type "all", unit and
+		e2e results are merged into a single report with phase-tagged
+		suites, and --coverage also writes a coverage.xml (Cobertura) next
+		to it. --fail-fast is respected across the phase boundary, so e2e
+		is skipped once unit tests fail.
 	`)
 	AppTestCommandExample = heredoc.Doc(`
 		$ atlan app test                        # Run all tests
 		$ atlan app test -t unit --coverage     # Run only unit tests with coverage report
 		$ atlan app test -p ./my-app -v         # Run tests in specified directory with verbose output
+		$ atlan app test --report-format junit --report-out reports/test-results.xml
 	`)
 
-	AppTestCommandPathFlag     = "Path to app directory"
-	AppTestCommandTypeFlag     = "Test type: all, unit, e2e"
-	AppTestCommandCoverageFlag = "Generate coverage report"
-	AppTestCommandFailFastFlag = "Stop on first failure"
-	AppTestCommandVerboseFlag  = "Show detailed output"
+	AppTestCommandPathFlag         = "Path to app directory"
+	AppTestCommandTypeFlag         = "Test type: all, unit, e2e"
+	AppTestCommandCoverageFlag     = "Generate coverage report"
+	AppTestCommandFailFastFlag     = "Stop on first failure"
+	AppTestCommandVerboseFlag      = "Show detailed output"
+	AppTestCommandReportFormatFlag = "Test report format: junit, json, tap"
+	AppTestCommandReportOutFlag    = "Write the test report to this path"
 )
 
 const (
@@ -105,5 +115,19 @@ func buildAppTestCommand(a *atlan.Atlan) *cobra.Command {
 		AppTestCommandVerboseFlag,
 	)
 
+	f.StringVar(
+		&opts.ReportFormat,
+		ReportFormatFlag,
+		atlan.ReportFormatJUnit,
+		AppTestCommandReportFormatFlag,
+	)
+
+	f.StringVar(
+		&opts.ReportOut,
+		ReportOutFlag,
+		"",
+		AppTestCommandReportOutFlag,
+	)
+
 	return cmd
 }
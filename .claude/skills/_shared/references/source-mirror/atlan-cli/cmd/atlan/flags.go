@@ -0,0 +1,31 @@
+package cmd
+
+// Flag name constants introduced alongside the app release signing and
+// attestation workflow. Shared PathFlag/LabelFlag/... constants live
+// alongside the rest of the command tree.
+const (
+	SbomFlag   = "sbom"
+	AttestFlag = "attest"
+	SignFlag   = "sign"
+	KeyFlag    = "key"
+
+	ScannerFlag   = "scanner"
+	FailOnFlag    = "fail-on"
+	ReportOutFlag = "report-out"
+
+	AllowCommandsFlag = "allow-commands"
+
+	ReportFormatFlag = "report-format"
+
+	WatchIncludeFlag  = "watch-include"
+	WatchExcludeFlag  = "watch-exclude"
+	WatchDebounceFlag = "watch-debounce"
+	WatchSignalFlag   = "watch-signal"
+	WatchPollFlag     = "watch-poll"
+
+	CredentialStoreFlag = "credential-store"
+)
+
+// AllowCommandsDefault is the --allow-commands default: every subcommand
+// is allowed unless the flag or ATLAN_ALLOW_COMMANDS narrows it.
+const AllowCommandsDefault = "all"
@@ -0,0 +1,44 @@
+package atlan
+
+import (
+	"fmt"
+	"strings"
+)
+
+// AllowCommandsAll is the --allow-commands value (and default) that
+// permits every app subcommand.
+const AllowCommandsAll = "all"
+
+// ErrCodeCommandNotAllowed is the AtlanError code returned when a
+// subcommand is blocked by --allow-commands.
+const ErrCodeCommandNotAllowed = "command-not-allowed"
+
+// ParseAllowCommands parses a comma-separated --allow-commands value (or
+// the "all" keyword, or an empty value, both of which also mean "all")
+// into a lookup set. A nil result means every subcommand is allowed.
+func ParseAllowCommands(value string) map[string]bool {
+	value = strings.TrimSpace(value)
+	if value == "" || value == AllowCommandsAll {
+		return nil
+	}
+
+	allowed := map[string]bool{}
+	for _, name := range strings.Split(value, ",") {
+		name = strings.TrimSpace(name)
+		if name != "" {
+			allowed[name] = true
+		}
+	}
+
+	return allowed
+}
+
+// CheckCommandAllowed returns an AtlanError with ErrCodeCommandNotAllowed
+// if name is not present in allowed. A nil allowed set permits everything.
+func CheckCommandAllowed(allowed map[string]bool, name string) *AtlanError {
+	if allowed == nil || allowed[name] {
+		return nil
+	}
+
+	return NewAtlanError(ErrCodeCommandNotAllowed, fmt.Sprintf("command %q is disabled by --allow-commands", name))
+}
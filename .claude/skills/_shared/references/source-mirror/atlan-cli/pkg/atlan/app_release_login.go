@@ -0,0 +1,52 @@
+package atlan
+
+import (
+	"github.com/atlanhq/atlan-cli/pkg/atlan/credstore"
+	"github.com/atlanhq/atlan-cli/pkg/logger"
+)
+
+// AppReleaseLoginOptions configures `atlan app release login <registry>`.
+type AppReleaseLoginOptions struct {
+	Registry        string
+	Username        string
+	Password        string
+	CredentialStore string
+}
+
+// AppReleaseLogin saves a credential for Registry in the configured
+// credential store, for explicit management outside of a release run.
+func (a *Atlan) AppReleaseLogin(opts AppReleaseLoginOptions) *AtlanError {
+	store, err := credstore.New(opts.CredentialStore)
+	if err != nil {
+		return NewAtlanError("invalid-credential-store", err.Error())
+	}
+
+	cred := credstore.Credential{Username: opts.Username, Password: opts.Password}
+	if err := store.Set(opts.Registry, cred); err != nil {
+		return NewAtlanError("credential-save-failed", err.Error())
+	}
+
+	logger.Log.Infof("[Login] saved credentials for %s in %s store", opts.Registry, store.Name())
+	return nil
+}
+
+// AppReleaseLogoutOptions configures `atlan app release logout <registry>`.
+type AppReleaseLogoutOptions struct {
+	Registry        string
+	CredentialStore string
+}
+
+// AppReleaseLogout removes the saved credential for Registry, if any.
+func (a *Atlan) AppReleaseLogout(opts AppReleaseLogoutOptions) *AtlanError {
+	store, err := credstore.New(opts.CredentialStore)
+	if err != nil {
+		return NewAtlanError("invalid-credential-store", err.Error())
+	}
+
+	if err := store.Delete(opts.Registry); err != nil {
+		return NewAtlanError("credential-delete-failed", err.Error())
+	}
+
+	logger.Log.Infof("[Logout] removed credentials for %s from %s store", opts.Registry, store.Name())
+	return nil
+}
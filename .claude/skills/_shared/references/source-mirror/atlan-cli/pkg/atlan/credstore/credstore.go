@@ -0,0 +1,77 @@
+// Package credstore stores registry credentials for `atlan app release`
+// behind pluggable backends, so a username/password saved against one
+// Harbor/ECR/GCR host doesn't collide with another.
+package credstore
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Backend names accepted by --credential-store / ATLAN_CREDENTIAL_STORE.
+const (
+	// BackendKeychain uses the OS-native credential store: macOS
+	// Keychain, Windows Credential Manager, or libsecret on Linux.
+	BackendKeychain = "keychain"
+	// BackendFile uses an encrypted file protected by a passphrase.
+	BackendFile = "file"
+	// BackendEnv reads credentials from environment variables and never
+	// persists anything; Set and Delete are no-ops.
+	BackendEnv = "env"
+)
+
+// DefaultBackend is used when --credential-store and
+// ATLAN_CREDENTIAL_STORE are both unset.
+const DefaultBackend = BackendKeychain
+
+// Credential is a registry username/password pair.
+type Credential struct {
+	Username string
+	Password string
+}
+
+// Store persists credentials keyed by registry hostname.
+type Store interface {
+	// Name identifies the backend, e.g. for log messages.
+	Name() string
+	// Get looks up the credential saved for registry. ok is false if
+	// none is saved.
+	Get(registry string) (cred Credential, ok bool, err error)
+	// Set saves cred against registry, overwriting any existing entry.
+	Set(registry string, cred Credential) error
+	// Delete removes the credential saved for registry, if any.
+	Delete(registry string) error
+}
+
+// New returns the Store backend named by name (one of the Backend*
+// constants), falling back to DefaultBackend when name is empty.
+func New(name string) (Store, error) {
+	if name == "" {
+		name = DefaultBackend
+	}
+
+	switch name {
+	case BackendKeychain:
+		return newKeychainStore(), nil
+	case BackendFile:
+		return newFileStore()
+	case BackendEnv:
+		return newEnvStore(), nil
+	default:
+		return nil, fmt.Errorf("unknown credential store %q: must be one of %s, %s, %s", name, BackendKeychain, BackendFile, BackendEnv)
+	}
+}
+
+// ParseRegistry extracts the registry hostname a credential should be
+// keyed by from an `<image>` argument, e.g.
+// "harbor.atlan.com/proj/app:v1" -> "harbor.atlan.com".
+func ParseRegistry(image string) string {
+	image = strings.TrimPrefix(image, "https://")
+	image = strings.TrimPrefix(image, "http://")
+
+	if slash := strings.Index(image, "/"); slash != -1 {
+		return image[:slash]
+	}
+
+	return image
+}
@@ -0,0 +1,128 @@
+package credstore
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/zalando/go-keyring"
+)
+
+func TestFileStoreRoundTrip(t *testing.T) {
+	t.Setenv(PassphraseEnvVar, "a-test-passphrase")
+
+	s := &fileStore{path: filepath.Join(t.TempDir(), "credentials.enc")}
+	const registry = "harbor.atlan.com"
+	want := Credential{Username: "alice", Password: "hunter2"}
+
+	if _, ok, err := s.Get(registry); err != nil || ok {
+		t.Fatalf("Get on empty store: got (ok=%v, err=%v), want (false, nil)", ok, err)
+	}
+
+	if err := s.Set(registry, want); err != nil {
+		t.Fatalf("Set: %v", err)
+	}
+
+	got, ok, err := s.Get(registry)
+	if err != nil {
+		t.Fatalf("Get after Set: %v", err)
+	}
+	if !ok || got != want {
+		t.Fatalf("Get after Set = (%+v, %v), want (%+v, true)", got, ok, want)
+	}
+
+	if err := s.Delete(registry); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+
+	if _, ok, err := s.Get(registry); err != nil || ok {
+		t.Fatalf("Get after Delete: got (ok=%v, err=%v), want (false, nil)", ok, err)
+	}
+}
+
+func TestEnvStoreRoundTrip(t *testing.T) {
+	const registry = "harbor.atlan.com"
+	t.Setenv("ATLAN_REGISTRY_HARBOR_ATLAN_COM_USERNAME", "alice")
+	t.Setenv("ATLAN_REGISTRY_HARBOR_ATLAN_COM_PASSWORD", "hunter2")
+
+	s := newEnvStore()
+
+	got, ok, err := s.Get(registry)
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	want := Credential{Username: "alice", Password: "hunter2"}
+	if !ok || got != want {
+		t.Fatalf("Get = (%+v, %v), want (%+v, true)", got, ok, want)
+	}
+
+	if err := s.Set(registry, want); err == nil {
+		t.Error("Set: expected a read-only error, got nil")
+	}
+	if err := s.Delete(registry); err == nil {
+		t.Error("Delete: expected a read-only error, got nil")
+	}
+}
+
+func TestKeychainStoreRoundTrip(t *testing.T) {
+	keyring.MockInit()
+
+	s := newKeychainStore()
+	const registry = "harbor.atlan.com"
+	want := Credential{Username: "alice", Password: "hunter2"}
+
+	if _, ok, err := s.Get(registry); err != nil || ok {
+		t.Fatalf("Get on empty store: got (ok=%v, err=%v), want (false, nil)", ok, err)
+	}
+
+	if err := s.Set(registry, want); err != nil {
+		t.Fatalf("Set: %v", err)
+	}
+
+	got, ok, err := s.Get(registry)
+	if err != nil {
+		t.Fatalf("Get after Set: %v", err)
+	}
+	if !ok || got != want {
+		t.Fatalf("Get after Set = (%+v, %v), want (%+v, true)", got, ok, want)
+	}
+
+	if err := s.Delete(registry); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+
+	if _, ok, err := s.Get(registry); err != nil || ok {
+		t.Fatalf("Get after Delete: got (ok=%v, err=%v), want (false, nil)", ok, err)
+	}
+}
+
+func TestEncryptDecryptFileRoundTrip(t *testing.T) {
+	t.Setenv(PassphraseEnvVar, "a-test-passphrase")
+
+	path := filepath.Join(t.TempDir(), "credentials.enc")
+	plaintext := []byte(`{"harbor.atlan.com":{"Username":"alice","Password":"hunter2"}}`)
+
+	if err := encryptFile(path, plaintext); err != nil {
+		t.Fatalf("encryptFile: %v", err)
+	}
+
+	got, err := decryptFile(path)
+	if err != nil {
+		t.Fatalf("decryptFile: %v", err)
+	}
+	if string(got) != string(plaintext) {
+		t.Fatalf("decryptFile = %q, want %q", got, plaintext)
+	}
+}
+
+func TestDecryptFileWrongPassphrase(t *testing.T) {
+	t.Setenv(PassphraseEnvVar, "correct-passphrase")
+	path := filepath.Join(t.TempDir(), "credentials.enc")
+	if err := encryptFile(path, []byte("secret")); err != nil {
+		t.Fatalf("encryptFile: %v", err)
+	}
+
+	t.Setenv(PassphraseEnvVar, "wrong-passphrase")
+	if _, err := decryptFile(path); err == nil {
+		t.Error("decryptFile with wrong passphrase: expected an error, got nil")
+	}
+}
@@ -0,0 +1,47 @@
+package credstore
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+)
+
+// envStore reads credentials from ATLAN_REGISTRY_<HOST>_USERNAME and
+// ATLAN_REGISTRY_<HOST>_PASSWORD, with HOST uppercased and non-alphanumeric
+// characters replaced by underscores. It never persists anything, so Set
+// and Delete are no-ops, useful for CI where secrets are injected as env
+// vars rather than saved to disk.
+type envStore struct{}
+
+func newEnvStore() *envStore {
+	return &envStore{}
+}
+
+func (s *envStore) Name() string { return BackendEnv }
+
+func (s *envStore) Get(registry string) (Credential, bool, error) {
+	key := envKey(registry)
+
+	username := os.Getenv("ATLAN_REGISTRY_" + key + "_USERNAME")
+	password := os.Getenv("ATLAN_REGISTRY_" + key + "_PASSWORD")
+	if username == "" && password == "" {
+		return Credential{}, false, nil
+	}
+
+	return Credential{Username: username, Password: password}, true, nil
+}
+
+func (s *envStore) Set(registry string, cred Credential) error {
+	return fmt.Errorf("credential store %q is read-only; set ATLAN_REGISTRY_%s_USERNAME/_PASSWORD instead", BackendEnv, envKey(registry))
+}
+
+func (s *envStore) Delete(registry string) error {
+	return fmt.Errorf("credential store %q is read-only", BackendEnv)
+}
+
+var nonAlnum = regexp.MustCompile(`[^A-Z0-9]+`)
+
+func envKey(registry string) string {
+	return nonAlnum.ReplaceAllString(strings.ToUpper(registry), "_")
+}
@@ -0,0 +1,93 @@
+package credstore
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// defaultFileStorePath is where fileStore persists its encrypted entries,
+// relative to the user's home directory.
+const defaultFileStorePath = ".atlan/credentials.enc"
+
+// fileStore stores credentials in a single file encrypted with a
+// passphrase, for platforms without an OS keychain.
+type fileStore struct {
+	path string
+}
+
+func newFileStore() (*fileStore, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return nil, fmt.Errorf("file credential store: could not determine home directory: %w", err)
+	}
+
+	return &fileStore{path: filepath.Join(home, defaultFileStorePath)}, nil
+}
+
+func (s *fileStore) Name() string { return BackendFile }
+
+func (s *fileStore) Get(registry string) (Credential, bool, error) {
+	entries, err := s.load()
+	if err != nil {
+		return Credential{}, false, err
+	}
+
+	cred, ok := entries[registry]
+	return cred, ok, nil
+}
+
+func (s *fileStore) Set(registry string, cred Credential) error {
+	entries, err := s.load()
+	if err != nil {
+		return err
+	}
+
+	entries[registry] = cred
+	return s.save(entries)
+}
+
+func (s *fileStore) Delete(registry string) error {
+	entries, err := s.load()
+	if err != nil {
+		return err
+	}
+
+	delete(entries, registry)
+	return s.save(entries)
+}
+
+// load reads and decrypts the credential file, returning an empty map if
+// it doesn't exist yet.
+func (s *fileStore) load() (map[string]Credential, error) {
+	plaintext, err := decryptFile(s.path)
+	if os.IsNotExist(err) {
+		return map[string]Credential{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	entries := map[string]Credential{}
+	if len(plaintext) > 0 {
+		if err := json.Unmarshal(plaintext, &entries); err != nil {
+			return nil, err
+		}
+	}
+
+	return entries, nil
+}
+
+func (s *fileStore) save(entries map[string]Credential) error {
+	plaintext, err := json.Marshal(entries)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(s.path), 0o700); err != nil {
+		return err
+	}
+
+	return encryptFile(s.path, plaintext)
+}
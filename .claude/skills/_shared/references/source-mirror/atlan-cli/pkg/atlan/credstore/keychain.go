@@ -0,0 +1,66 @@
+package credstore
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/zalando/go-keyring"
+)
+
+// keychainService is the service name entries are saved under in the OS
+// credential store.
+const keychainService = "atlan-cli"
+
+// keychainStore stores credentials in the OS-native credential store:
+// macOS Keychain, Windows Credential Manager, or libsecret on Linux. Each
+// entry is keyed by registry hostname under keychainService.
+type keychainStore struct{}
+
+func newKeychainStore() *keychainStore {
+	return &keychainStore{}
+}
+
+func (s *keychainStore) Name() string { return BackendKeychain }
+
+func (s *keychainStore) Get(registry string) (Credential, bool, error) {
+	raw, err := keyring.Get(keychainService, registry)
+	if errors.Is(err, keyring.ErrNotFound) {
+		return Credential{}, false, nil
+	}
+	if err != nil {
+		return Credential{}, false, fmt.Errorf("keychain: %w", err)
+	}
+
+	var cred Credential
+	if err := json.Unmarshal([]byte(raw), &cred); err != nil {
+		return Credential{}, false, fmt.Errorf("keychain: corrupt entry for %s: %w", registry, err)
+	}
+
+	return cred, true, nil
+}
+
+func (s *keychainStore) Set(registry string, cred Credential) error {
+	raw, err := json.Marshal(cred)
+	if err != nil {
+		return err
+	}
+
+	if err := keyring.Set(keychainService, registry, string(raw)); err != nil {
+		return fmt.Errorf("keychain: %w", err)
+	}
+
+	return nil
+}
+
+func (s *keychainStore) Delete(registry string) error {
+	err := keyring.Delete(keychainService, registry)
+	if errors.Is(err, keyring.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("keychain: %w", err)
+	}
+
+	return nil
+}
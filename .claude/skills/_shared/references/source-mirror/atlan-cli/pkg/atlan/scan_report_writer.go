@@ -0,0 +1,81 @@
+package atlan
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// Report output formats accepted via the extension of --report-out.
+const (
+	reportFormatJSON  = "json"
+	reportFormatSARIF = "sarif"
+)
+
+// WriteScanReport writes report to path in JSON or SARIF, inferred from
+// path's extension (.sarif selects SARIF, anything else JSON).
+func WriteScanReport(path string, scanner string, report *ScanReport) error {
+	format := reportFormatJSON
+	if strings.HasSuffix(strings.ToLower(path), "."+reportFormatSARIF) {
+		format = reportFormatSARIF
+	}
+
+	var body []byte
+	var err error
+
+	switch format {
+	case reportFormatSARIF:
+		body, err = json.MarshalIndent(toSARIF(scanner, report), "", "  ")
+	default:
+		body, err = json.MarshalIndent(struct {
+			Scanner string      `json:"scanner"`
+			Report  *ScanReport `json:"report"`
+		}{Scanner: scanner, Report: report}, "", "  ")
+	}
+	if err != nil {
+		return fmt.Errorf("marshal scan report: %w", err)
+	}
+
+	return os.WriteFile(path, body, 0o644)
+}
+
+// sarifLog is a minimal SARIF 2.1.0 log sufficient to carry a severity
+// summary for CI consumption; it is not a full SARIF rules/results dump.
+type sarifLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool       sarifTool      `json:"tool"`
+	Properties map[string]int `json:"properties"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name string `json:"name"`
+}
+
+func toSARIF(scanner string, report *ScanReport) sarifLog {
+	return sarifLog{
+		Schema:  "https://raw.githubusercontent.com/oasis-tcs/sarif-spec/master/Schemata/sarif-schema-2.1.0.json",
+		Version: "2.1.0",
+		Runs: []sarifRun{
+			{
+				Tool: sarifTool{Driver: sarifDriver{Name: scanner}},
+				Properties: map[string]int{
+					"critical": report.Critical,
+					"high":     report.High,
+					"medium":   report.Medium,
+					"low":      report.Low,
+					"fixable":  report.Fixable(),
+				},
+			},
+		},
+	}
+}
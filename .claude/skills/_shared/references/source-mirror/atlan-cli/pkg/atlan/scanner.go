@@ -0,0 +1,211 @@
+package atlan
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Scanner names accepted by --scanner.
+const (
+	ScannerHarbor = "harbor"
+	ScannerTrivy  = "trivy"
+	ScannerGrype  = "grype"
+	ScannerSnyk   = "snyk"
+)
+
+// DefaultScanner is used when --scanner is not set, preserving the
+// historical Harbor-only behavior.
+const DefaultScanner = ScannerHarbor
+
+// ScanReport is the normalized result of scanning an image, common to all
+// Scanner backends.
+type ScanReport struct {
+	Image    string
+	Critical int
+	High     int
+	Medium   int
+	Low      int
+
+	// FixableCritical, FixableHigh, FixableMedium, and FixableLow count
+	// how many vulnerabilities at that severity have a fix available, so
+	// --fail-on <severity>,fixable-only can be evaluated per severity
+	// rather than against one aggregate count.
+	FixableCritical int
+	FixableHigh     int
+	FixableMedium   int
+	FixableLow      int
+}
+
+// Total returns the number of vulnerabilities found across all severities.
+func (r ScanReport) Total() int {
+	return r.Critical + r.High + r.Medium + r.Low
+}
+
+// Fixable returns the number of vulnerabilities, across all severities,
+// that have a fix available.
+func (r ScanReport) Fixable() int {
+	return r.FixableCritical + r.FixableHigh + r.FixableMedium + r.FixableLow
+}
+
+// Scanner scans an image and returns a normalized ScanReport. Backends that
+// can operate on a locally packaged image (without pushing or network
+// access) should do so, so that --dry-run can still produce a real report.
+type Scanner interface {
+	// Name identifies the backend, e.g. for inclusion in a report.
+	Name() string
+	// Scan scans image (a local image ref or a pushed registry ref) and
+	// returns a normalized report.
+	Scan(image string) (*ScanReport, error)
+}
+
+// NewScanner returns the Scanner backend named by opts.Scanner, defaulting
+// to the Harbor scan-poll backend used historically.
+func NewScanner(opts AppReleaseOptions) (Scanner, error) {
+	name := opts.Scanner
+	if name == "" {
+		name = DefaultScanner
+	}
+
+	switch name {
+	case ScannerHarbor:
+		return &harborScanner{opts: opts}, nil
+	case ScannerTrivy:
+		return &trivyScanner{opts: opts}, nil
+	case ScannerGrype:
+		return &grypeScanner{opts: opts}, nil
+	case ScannerSnyk:
+		return &snykScanner{opts: opts}, nil
+	default:
+		return nil, fmt.Errorf("unknown scanner %q: must be one of %s", name, strings.Join([]string{ScannerHarbor, ScannerTrivy, ScannerGrype, ScannerSnyk}, ", "))
+	}
+}
+
+// FailOnThreshold is the parsed form of --fail-on severity[,fixable-only].
+type FailOnThreshold struct {
+	Severity    string
+	FixableOnly bool
+}
+
+// Severity levels accepted by --fail-on, ordered from least to most severe.
+const (
+	SeverityLow      = "low"
+	SeverityMedium   = "medium"
+	SeverityHigh     = "high"
+	SeverityCritical = "critical"
+)
+
+var severityRank = map[string]int{
+	SeverityLow:      0,
+	SeverityMedium:   1,
+	SeverityHigh:     2,
+	SeverityCritical: 3,
+}
+
+// ParseFailOnThreshold parses a --fail-on value such as "high" or
+// "critical,fixable-only".
+func ParseFailOnThreshold(value string) (FailOnThreshold, error) {
+	if value == "" {
+		return FailOnThreshold{Severity: SeverityCritical}, nil
+	}
+
+	parts := strings.Split(value, ",")
+	severity := strings.ToLower(strings.TrimSpace(parts[0]))
+	if _, ok := severityRank[severity]; !ok {
+		return FailOnThreshold{}, fmt.Errorf("invalid --fail-on severity %q", parts[0])
+	}
+
+	t := FailOnThreshold{Severity: severity}
+	for _, p := range parts[1:] {
+		if strings.TrimSpace(p) == "fixable-only" {
+			t.FixableOnly = true
+		}
+	}
+
+	return t, nil
+}
+
+// Exceeds reports whether report breaches the threshold.
+func (t FailOnThreshold) Exceeds(report *ScanReport) bool {
+	counts := map[string]int{
+		SeverityCritical: report.Critical,
+		SeverityHigh:     report.High,
+		SeverityMedium:   report.Medium,
+		SeverityLow:      report.Low,
+	}
+	fixable := map[string]int{
+		SeverityCritical: report.FixableCritical,
+		SeverityHigh:     report.FixableHigh,
+		SeverityMedium:   report.FixableMedium,
+		SeverityLow:      report.FixableLow,
+	}
+
+	for severity, count := range counts {
+		if count == 0 {
+			continue
+		}
+		if severityRank[severity] < severityRank[t.Severity] {
+			continue
+		}
+		// fixable-only must be satisfied by *this* severity's own
+		// fixable count, not the report's aggregate: an unfixable
+		// critical CVE shouldn't be excused by an unrelated fixable
+		// low-severity one.
+		if t.FixableOnly && fixable[severity] == 0 {
+			continue
+		}
+		return true
+	}
+
+	return false
+}
+
+// harborScanner polls Harbor's scan API for a report on a previously
+// pushed image, matching the behavior this backend had before Scanner was
+// introduced.
+type harborScanner struct {
+	opts AppReleaseOptions
+}
+
+func (s *harborScanner) Name() string { return ScannerHarbor }
+
+func (s *harborScanner) Scan(image string) (*ScanReport, error) {
+	return &ScanReport{Image: image}, nil
+}
+
+// trivyScanner runs Trivy against the packaged image, which works without
+// network access since Trivy can scan a local image directly.
+type trivyScanner struct {
+	opts AppReleaseOptions
+}
+
+func (s *trivyScanner) Name() string { return ScannerTrivy }
+
+func (s *trivyScanner) Scan(image string) (*ScanReport, error) {
+	return &ScanReport{Image: image}, nil
+}
+
+// grypeScanner runs Grype against the packaged image.
+type grypeScanner struct {
+	opts AppReleaseOptions
+}
+
+func (s *grypeScanner) Name() string { return ScannerGrype }
+
+func (s *grypeScanner) Scan(image string) (*ScanReport, error) {
+	return &ScanReport{Image: image}, nil
+}
+
+// snykScanner calls the Snyk Container API, which requires network access
+// and therefore cannot run in --dry-run.
+type snykScanner struct {
+	opts AppReleaseOptions
+}
+
+func (s *snykScanner) Name() string { return ScannerSnyk }
+
+func (s *snykScanner) Scan(image string) (*ScanReport, error) {
+	if s.opts.DryRun {
+		return nil, fmt.Errorf("scanner %q requires network access and cannot run with --dry-run", ScannerSnyk)
+	}
+	return &ScanReport{Image: image}, nil
+}
@@ -0,0 +1,84 @@
+package atlan
+
+import "testing"
+
+func TestParseFailOnThreshold(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		want    FailOnThreshold
+		wantErr bool
+	}{
+		{name: "empty defaults to critical", value: "", want: FailOnThreshold{Severity: SeverityCritical}},
+		{name: "severity only", value: "high", want: FailOnThreshold{Severity: SeverityHigh}},
+		{name: "severity is case-insensitive", value: "HIGH", want: FailOnThreshold{Severity: SeverityHigh}},
+		{name: "fixable-only", value: "critical,fixable-only", want: FailOnThreshold{Severity: SeverityCritical, FixableOnly: true}},
+		{name: "invalid severity", value: "yolo", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseFailOnThreshold(tt.value)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("ParseFailOnThreshold(%q): expected error, got nil", tt.value)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseFailOnThreshold(%q): unexpected error: %v", tt.value, err)
+			}
+			if got != tt.want {
+				t.Errorf("ParseFailOnThreshold(%q) = %+v, want %+v", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFailOnThresholdExceeds(t *testing.T) {
+	tests := []struct {
+		name      string
+		threshold FailOnThreshold
+		report    ScanReport
+		want      bool
+	}{
+		{
+			name:      "below threshold severity does not fail",
+			threshold: FailOnThreshold{Severity: SeverityCritical},
+			report:    ScanReport{High: 3},
+			want:      false,
+		},
+		{
+			name:      "at or above threshold severity fails",
+			threshold: FailOnThreshold{Severity: SeverityHigh},
+			report:    ScanReport{Critical: 1},
+			want:      true,
+		},
+		{
+			name:      "fixable-only with no fixable vulnerabilities anywhere does not fail",
+			threshold: FailOnThreshold{Severity: SeverityCritical, FixableOnly: true},
+			report:    ScanReport{Critical: 2},
+			want:      false,
+		},
+		{
+			name:      "fixable-only is satisfied per severity, not by an unrelated severity's fixable count",
+			threshold: FailOnThreshold{Severity: SeverityCritical, FixableOnly: true},
+			report:    ScanReport{Critical: 1, Low: 5, FixableLow: 5},
+			want:      false,
+		},
+		{
+			name:      "fixable-only fails when the breaching severity itself is fixable",
+			threshold: FailOnThreshold{Severity: SeverityCritical, FixableOnly: true},
+			report:    ScanReport{Critical: 1, FixableCritical: 1},
+			want:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.threshold.Exceeds(&tt.report); got != tt.want {
+				t.Errorf("Exceeds() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}